@@ -139,6 +139,98 @@ func bisection_method(f realfunc, a float64, b float64) float64 {
 }
 /*  End of bisection_method.                                                  */
 
+/*  One row of diagnostic output from bisection_method_with_trace, recording  *
+ *  everything needed to empirically check the order of convergence.          */
+type trace_record struct {
+    Iteration    int
+    X            float64
+    FX           float64
+    BracketWidth float64
+    ErrorRatio   float64
+}
+
+/*  Same algorithm as bisection_method, but records a trace_record for every  *
+ *  iteration instead of only returning the final midpoint. Bisection has     *
+ *  linear convergence, order p = 1, so ErrorRatio here should settle near    *
+ *  0.5 (the bracket, and hence the error, is halved every step).             */
+func bisection_method_with_trace(f realfunc, a float64, b float64) []trace_record {
+
+    const maximum_number_of_iterations uint32 = 64
+    const epsilon float64 = 2.220446049250313E-16
+    const p float64 = 1.0
+
+    var iters uint32
+    var midpoint float64
+    var left, right float64
+    var trace []trace_record
+
+    /*  Previous two iterates, needed to compute ErrorRatio.                  */
+    var x_prev, x_prev_prev float64
+    var have_prev, have_prev_prev bool
+
+    var a_eval = f(a)
+    var b_eval = f(b)
+
+    if a_eval == 0.0 {
+        return []trace_record{{Iteration: 0, X: a, FX: 0.0, BracketWidth: 0.0, ErrorRatio: math.NaN()}}
+    }
+
+    if b_eval == 0.0 {
+        return []trace_record{{Iteration: 0, X: b, FX: 0.0, BracketWidth: 0.0, ErrorRatio: math.NaN()}}
+    }
+
+    if a_eval < b_eval {
+        if b_eval < 0.0 || a_eval > 0.0 {
+            return nil
+        }
+
+        left, right = a, b
+    } else {
+        if a_eval < 0.0 || b_eval > 0.0 {
+            return nil
+        }
+
+        left, right = b, a
+    }
+
+    midpoint = 0.5 * (a + b)
+
+    for iters = 0; iters < maximum_number_of_iterations; iters += 1 {
+        var eval = f(midpoint)
+        var error_ratio = math.NaN()
+
+        if have_prev && have_prev_prev {
+            error_ratio = math.Abs(midpoint-x_prev) / math.Pow(math.Abs(x_prev-x_prev_prev), p)
+        }
+
+        trace = append(trace, trace_record{
+            Iteration:    int(iters),
+            X:            midpoint,
+            FX:           eval,
+            BracketWidth: right - left,
+            ErrorRatio:   error_ratio,
+        })
+
+        x_prev_prev, have_prev_prev = x_prev, have_prev
+        x_prev, have_prev = midpoint, true
+
+        if math.Abs(eval) <= epsilon {
+            break
+        }
+
+        if eval < 0.0 {
+            left = midpoint
+            midpoint = 0.5 * (midpoint + right)
+        } else {
+            right = midpoint
+            midpoint = 0.5 * (left + midpoint)
+        }
+    }
+
+    return trace
+}
+/*  End of bisection_method_with_trace.                                       */
+
 /*  Main routine used for testing our implementation of the bisection method. */
 func main() {
 
@@ -0,0 +1,161 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of mitx_mathematics_programming_examples.               *
+ *                                                                            *
+ *  mitx_mathematics_programming_examples is free software: you can           *
+ *  redistribute it and/or modify it under the terms of the GNU General       *
+ *  Public License as published by the Free Software Foundation, either       *
+ *  version 3 of the License, or (at your option) any later version.         *
+ *                                                                            *
+ *  mitx_mathematics_programming_examples is distributed in the hope that     *
+ *  it will be useful but WITHOUT ANY WARRANTY; without even the implied      *
+ *  warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.          *
+ *  See the GNU General Public License for more details.                      *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with mitx_mathematics_programming_examples. If not, see             *
+ *  <https://www.gnu.org/licenses/>.                                          *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Calculates the root of a function using Brent's method, a hybrid      *
+ *      bracketing algorithm combining the safety of bisection with the       *
+ *      speed of inverse quadratic interpolation and the secant method.       *
+ ******************************************************************************
+ *  Author: Ryan Maguire                                                      *
+ *  Date:   2026/07/26                                                       *
+ ******************************************************************************/
+package main
+
+/*  Only standard library imports are needed.                                 */
+import (
+    "fmt"   /*  Printf provided here, used for printing text to the screen.   */
+    "math"  /*  Abs and NaN, found here.                                      */
+)
+
+/*  Function pointer notation is a little confusing. Create a typedef for it  *
+ *  so we do not need to explicitly use it later.                             */
+type realfunc func(x float64) float64
+
+/*  Computes the root of a function using Brent's method. At each step the    *
+ *  algorithm tries inverse quadratic interpolation through the last three    *
+ *  distinct function values, falls back to a secant step when only two are   *
+ *  available, and falls back further to bisection whenever either of those   *
+ *  would land outside the bracket or is not shrinking the bracket fast       *
+ *  enough. This guarantees bisection's worst-case convergence while usually  *
+ *  running much faster.                                                      */
+func brents_method(f realfunc, a float64, b float64) float64 {
+
+    /*  Tell the algorithm to stop after several iterations to avoid an       *
+     *  infinite loop, same reasoning as bisection_method.                    */
+    const maximum_number_of_iterations uint32 = 100
+
+    /*  Getting exact roots is hard using floating-point numbers. Allow a     *
+     *  tolerance in our computation. This value is double precision epsilon. */
+    const epsilon float64 = 2.220446049250313E-16
+
+    var fa = f(a)
+    var fb = f(b)
+
+    /*  We need one evaluation to be negative and one to be positive.         *
+     *  Abort if both have the same sign.                                     */
+    if fa*fb > 0.0 {
+        return math.NaN()
+    }
+
+    /*  Brent's method keeps b as the best estimate so far, meaning the one   *
+     *  with the smaller |f|. Swap if a is actually the better of the two.    */
+    if math.Abs(fa) < math.Abs(fb) {
+        a, b = b, a
+        fa, fb = fb, fa
+    }
+
+    /*  c is the previous value of b, the "contrapoint" used for inverse      *
+     *  quadratic interpolation. d is the value of b two steps back, only     *
+     *  used to decide whether to fall back to bisection.                     */
+    var c = a
+    var fc = fa
+    var d = a
+    var mflag = true
+    var iters uint32
+
+    for iters = 0; iters < maximum_number_of_iterations; iters += 1 {
+
+        /*  If f(b) is very small, or the bracket has collapsed, we are close *
+         *  to a root and can break out of this loop. Check for this.        */
+        if fb == 0.0 || math.Abs(b-a) <= epsilon {
+            break
+        }
+
+        var s float64
+
+        /*  Inverse quadratic interpolation needs three distinct function     *
+         *  values. Use it when available, otherwise fall back to a secant    *
+         *  step through a and b.                                             */
+        if fa != fc && fb != fc {
+            s = a*fb*fc/((fa-fb)*(fa-fc)) +
+                b*fa*fc/((fb-fa)*(fb-fc)) +
+                c*fa*fb/((fc-fa)*(fc-fb))
+        } else {
+            s = b - fb*(b-a)/(fb-fa)
+        }
+
+        /*  The interpolated (or secant) point s is only accepted if it       *
+         *  falls strictly between (3a + b) / 4 and b, and if the bracket is  *
+         *  shrinking fast enough. Otherwise fall back to bisection, the      *
+         *  standard safeguards for Brent's method.                           */
+        var low = 0.25 * (3.0*a + b)
+        var high = b
+
+        if low > high {
+            low, high = high, low
+        }
+
+        var condition1 = s < low || s > high
+        var condition2 = mflag && math.Abs(s-b) >= 0.5*math.Abs(b-c)
+        var condition3 = !mflag && math.Abs(s-b) >= 0.5*math.Abs(c-d)
+        var condition4 = mflag && math.Abs(b-c) <= epsilon
+        var condition5 = !mflag && math.Abs(c-d) <= epsilon
+
+        if condition1 || condition2 || condition3 || condition4 || condition5 {
+            s = 0.5 * (a + b)
+            mflag = true
+        } else {
+            mflag = false
+        }
+
+        var fs = f(s)
+
+        d = c
+        c, fc = b, fb
+
+        /*  Replace whichever of a, b no longer brackets the root with s.     */
+        if fa*fs < 0.0 {
+            b, fb = s, fs
+        } else {
+            a, fa = s, fs
+        }
+
+        /*  Keep b as the better estimate, the one with the smaller |f|.      */
+        if math.Abs(fa) < math.Abs(fb) {
+            a, b = b, a
+            fa, fb = fb, fa
+        }
+    }
+
+    return b
+}
+/*  End of brents_method.                                                     */
+
+/*  Main routine used for testing our implementation of Brent's method.       */
+func main() {
+
+    /*  pi is somewhere between 3 and 4, and it is a root to sine.            */
+    const a float64 = 3.0
+    const b float64 = 4.0
+
+    /*  Compute pi using Brent's method. We should get pi = 3.14159...,       *
+     *  accurate to about 16 decimals.                                        */
+    var pi = brents_method(math.Sin, a, b)
+    fmt.Printf("pi = %.16f\n", pi)
+}
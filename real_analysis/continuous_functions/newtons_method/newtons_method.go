@@ -0,0 +1,216 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of mitx_mathematics_programming_examples.               *
+ *                                                                            *
+ *  mitx_mathematics_programming_examples is free software: you can           *
+ *  redistribute it and/or modify it under the terms of the GNU General       *
+ *  Public License as published by the Free Software Foundation, either       *
+ *  version 3 of the License, or (at your option) any later version.         *
+ *                                                                            *
+ *  mitx_mathematics_programming_examples is distributed in the hope that     *
+ *  it will be useful but WITHOUT ANY WARRANTY; without even the implied      *
+ *  warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.          *
+ *  See the GNU General Public License for more details.                      *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with mitx_mathematics_programming_examples. If not, see             *
+ *  <https://www.gnu.org/licenses/>.                                          *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Calculates the root of a function using Newton's method, with a       *
+ *      numerical-derivative fallback and safeguarded stepping.               *
+ ******************************************************************************
+ *  Author: Ryan Maguire                                                      *
+ *  Date:   2026/07/26                                                       *
+ ******************************************************************************/
+package main
+
+/*  Only standard library imports are needed.                                 */
+import (
+    "fmt"   /*  Printf provided here, used for printing text to the screen.   */
+    "math"  /*  Abs, Sqrt, and NaN, found here.                                */
+)
+
+/*  Function pointer notation is a little confusing. Create a typedef for it  *
+ *  so we do not need to explicitly use it later.                             */
+type realfunc func(x float64) float64
+
+/*  Tell the algorithm to stop after several iterations to avoid an infinite  *
+ *  loop. Newton's method converges quadratically, so this is generous.      */
+const maximum_number_of_iterations uint32 = 64
+
+/*  Getting exact roots is hard using floating-point numbers. Allow a         *
+ *  tolerance in our computation. This value is double precision epsilon.     */
+const epsilon float64 = 2.220446049250313E-16
+
+/*  Computes the root of a function using Newton's method, with two           *
+ *  safeguards absent from the existing Steffensen file: division by a        *
+ *  near-zero derivative is refused instead of overflowing, and a step that   *
+ *  would make |f| grow is halved (backtracking line search) instead of       *
+ *  taken outright.                                                           */
+func newtons_method(f realfunc, fprime realfunc, x0 float64) float64 {
+
+    /*  How many times a single step may be halved before giving up on it     *
+     *  and taking it anyway.                                                 */
+    const maximum_number_of_halvings = 10
+
+    var xn = x0
+    var iters uint32
+
+    for iters = 0; iters < maximum_number_of_iterations; iters += 1 {
+        var f_xn = f(xn)
+
+        if math.Abs(f_xn) <= epsilon {
+            break
+        }
+
+        var fprime_xn = fprime(xn)
+
+        /*  Division-by-zero guard. A near-zero derivative means the tangent  *
+         *  line is nearly horizontal, Newton's method has nothing useful to  *
+         *  say here.                                                         */
+        if math.Abs(fprime_xn) < epsilon {
+            return math.NaN()
+        }
+
+        var step = f_xn / fprime_xn
+        var xnext = xn - step
+        var f_xnext = f(xnext)
+
+        /*  Backtracking line search. If the proposed step overshoots and     *
+         *  makes |f| worse, halve the step and try again.                    */
+        var halvings int
+        for halvings = 0; halvings < maximum_number_of_halvings && math.Abs(f_xnext) > math.Abs(f_xn); halvings += 1 {
+            step *= 0.5
+            xnext = xn - step
+            f_xnext = f(xnext)
+        }
+
+        xn = xnext
+    }
+
+    return xn
+}
+/*  End of newtons_method.                                                    */
+
+/*  Same as newtons_method, but estimates f'(x) with a central difference     *
+ *  instead of requiring the caller to supply it. The step size h scales      *
+ *  with x so that the estimate stays well-conditioned for both small and     *
+ *  large arguments.                                                          */
+func newtons_method_numeric(f realfunc, x0 float64) float64 {
+    var fprime = func(x float64) float64 {
+        var h = math.Sqrt(epsilon) * math.Max(math.Abs(x), 1.0)
+        return (f(x+h) - f(x-h)) / (2.0 * h)
+    }
+
+    return newtons_method(f, fprime, x0)
+}
+/*  End of newtons_method_numeric.                                            */
+
+/*  Same as newtons_method, but accepts a bracket [a, b] known to contain a    *
+ *  root. Whenever the proposed Newton step would leave the bracket, a        *
+ *  bisection step is taken instead, the classic Numerical Recipes            *
+ *  safeguarded-Newton pattern. The bracket itself is narrowed after every    *
+ *  step using the sign of f, same as bisection_method.                      */
+func newtons_method_bracketed(f realfunc, fprime realfunc, a float64, b float64, x0 float64) float64 {
+    var root, _ = newtons_method_bracketed_with_count(f, fprime, a, b, x0)
+    return root
+}
+/*  End of newtons_method_bracketed.                                          */
+
+/*  Does the actual work for newtons_method_bracketed, additionally reporting  *
+ *  how many iterations were taken. Split out so a test can check that real   *
+ *  Newton steps are being taken (few iterations, quadratic convergence)      *
+ *  rather than every step silently falling back to bisection (which would    *
+ *  still reach the right answer, just after far more iterations).            */
+func newtons_method_bracketed_with_count(f realfunc, fprime realfunc, a float64, b float64, x0 float64) (float64, uint32) {
+
+    var left, right = a, b
+    var left_eval = f(a)
+
+    if left_eval == 0.0 {
+        return a, 0
+    }
+
+    if f(b) == 0.0 {
+        return b, 0
+    }
+
+    if left_eval*f(b) > 0.0 {
+        return math.NaN(), 0
+    }
+
+    if left_eval > 0.0 {
+        left, right = b, a
+    }
+
+    var xn = x0
+    var iters uint32
+
+    for iters = 0; iters < maximum_number_of_iterations; iters += 1 {
+        var f_xn = f(xn)
+
+        if math.Abs(f_xn) <= epsilon {
+            break
+        }
+
+        var fprime_xn = fprime(xn)
+        var xnext float64
+
+        /*  left and right are oriented by the sign of f, not by numeric      *
+         *  order (f(left) < 0 < f(right)), so left may be the larger of the  *
+         *  two. Normalize to an ascending [lo, hi] before using it as a      *
+         *  containment check, otherwise the check below is backwards and    *
+         *  rejects almost every candidate xnext.                             */
+        var lo, hi = left, right
+
+        if lo > hi {
+            lo, hi = hi, lo
+        }
+
+        /*  Fall back to a bisection step whenever the derivative is          *
+         *  unusable or the Newton step would leave [lo, hi].                 */
+        if math.Abs(fprime_xn) < epsilon {
+            xnext = 0.5 * (left + right)
+        } else {
+            xnext = xn - f_xn/fprime_xn
+
+            if xnext < lo || xnext > hi {
+                xnext = 0.5 * (left + right)
+            }
+        }
+
+        var f_xnext = f(xnext)
+
+        /*  Narrow the bracket, preserving the invariant f(left) < 0 < f(right).*/
+        if f_xnext < 0.0 {
+            left = xnext
+        } else {
+            right = xnext
+        }
+
+        xn = xnext
+    }
+
+    return xn, iters
+}
+/*  End of newtons_method_bracketed_with_count.                               */
+
+/*  Main routine used for testing our implementation of Newton's method.      */
+func main() {
+
+    /*  pi is somewhere between 3 and 4, and it is a root to sine, whose      *
+     *  derivative is cosine.                                                 */
+    const a float64 = 3.0
+    const b float64 = 4.0
+    const x0 float64 = 3.0
+
+    var pi_exact = newtons_method(math.Sin, math.Cos, x0)
+    var pi_numeric = newtons_method_numeric(math.Sin, x0)
+    var pi_bracketed = newtons_method_bracketed(math.Sin, math.Cos, a, b, x0)
+
+    fmt.Printf("pi (exact derivative)     = %.16f\n", pi_exact)
+    fmt.Printf("pi (numeric derivative)   = %.16f\n", pi_numeric)
+    fmt.Printf("pi (safeguarded, bracket) = %.16f\n", pi_bracketed)
+}
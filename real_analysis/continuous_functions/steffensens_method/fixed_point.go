@@ -0,0 +1,161 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of mitx_mathematics_programming_examples.               *
+ *                                                                            *
+ *  mitx_mathematics_programming_examples is free software: you can           *
+ *  redistribute it and/or modify it under the terms of the GNU General       *
+ *  Public License as published by the Free Software Foundation, either       *
+ *  version 3 of the License, or (at your option) any later version.         *
+ *                                                                            *
+ *  mitx_mathematics_programming_examples is distributed in the hope that     *
+ *  it will be useful but WITHOUT ANY WARRANTY; without even the implied      *
+ *  warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.          *
+ *  See the GNU General Public License for more details.                      *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with mitx_mathematics_programming_examples. If not, see             *
+ *  <https://www.gnu.org/licenses/>.                                          *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Provides fixed_point, the plain iteration x_{n+1} = g(x_n) that       *
+ *      Steffensen's method is built on top of, with an optional Aitken       *
+ *      Delta-squared acceleration switch.                                    *
+ ******************************************************************************
+ *  Author: Ryan Maguire                                                      *
+ *  Date:   2026/07/26                                                       *
+ ******************************************************************************/
+package main
+
+/*  Only standard library imports are needed.                                 */
+import "math"
+
+/*  Settings shared by fixed_point and fixed_point_iteration_count.           */
+type options struct {
+
+    /*  How close |x_{n+1} - x_n| must be to declare convergence. Zero means  *
+     *  "use the package default".                                           */
+    Tolerance float64
+
+    /*  Maximum number of iterations to perform. Zero means "use the         *
+     *  package default".                                                     */
+    MaxIterations uint32
+
+    /*  Whether to apply Aitken's Delta-squared transform to each triple of   *
+     *  iterates. This is exactly the acceleration Steffensen's method uses  *
+     *  implicitly.                                                          */
+    Accelerate bool
+}
+
+/*  The maximum allowed error. This is 4x double precision epsilon, the same  *
+ *  tolerance steffensens_method uses.                                       */
+const fixed_point_epsilon float64 = 8.881784197001252e-16
+
+/*  Fixed-point iteration is quick to converge (or diverge), so a small       *
+ *  iteration cap is plenty, again matching steffensens_method.              */
+const fixed_point_maximum_number_of_iterations uint32 = 16
+
+/*  Fills in zero-valued fields of opts with the package defaults.            */
+func fixed_point_resolve_options(opts options) options {
+    if opts.Tolerance <= 0.0 {
+        opts.Tolerance = fixed_point_epsilon
+    }
+
+    if opts.MaxIterations == 0 {
+        opts.MaxIterations = fixed_point_maximum_number_of_iterations
+    }
+
+    return opts
+}
+
+/*  Applies Aitken's Delta-squared transform to the triple (x0, x1, x2),      *
+ *  which extrapolates a linearly convergent sequence to its limit:           *
+ *  x0 - (x1 - x0)^2 / (x2 - 2*x1 + x0). This is precisely the derivation     *
+ *  steffensens_method uses implicitly, written here for fixed_point to use   *
+ *  explicitly.                                                               */
+func aitken_delta_squared(x0 float64, x1 float64, x2 float64) float64 {
+    var denominator = x2 - 2.0*x1 + x0
+
+    if denominator == 0.0 {
+        return x2
+    }
+
+    return x0 - (x1-x0)*(x1-x0)/denominator
+}
+
+/*  Iterates x_{n+1} = g(x_n) from x0 until successive iterates are within    *
+ *  opts.Tolerance of one another or opts.MaxIterations is reached. With      *
+ *  opts.Accelerate set, every triple of iterates is collapsed via Aitken's   *
+ *  Delta-squared transform before continuing, which is how Steffensen's      *
+ *  method achieves quadratic convergence out of plain fixed-point iteration. */
+func fixed_point(g realfunc, x0 float64, opts options) float64 {
+
+    opts = fixed_point_resolve_options(opts)
+
+    var xn = x0
+    var iters uint32
+
+    for iters = 0; iters < opts.MaxIterations; iters += 1 {
+        if !opts.Accelerate {
+            var xnext = g(xn)
+
+            if math.Abs(xnext-xn) < opts.Tolerance {
+                return xnext
+            }
+
+            xn = xnext
+            continue
+        }
+
+        /*  Accelerated case. Generate the next two iterates and collapse     *
+         *  the triple (xn, x1, x2) with Aitken's transform.                  */
+        var x1 = g(xn)
+        var x2 = g(x1)
+        var accelerated = aitken_delta_squared(xn, x1, x2)
+
+        if math.Abs(accelerated-xn) < opts.Tolerance {
+            return accelerated
+        }
+
+        xn = accelerated
+    }
+
+    return xn
+}
+/*  End of fixed_point.                                                       */
+
+/*  Same iteration as fixed_point, but reports how many steps were taken      *
+ *  instead of the final estimate.                                           */
+func fixed_point_iteration_count(g realfunc, x0 float64, opts options) uint32 {
+
+    opts = fixed_point_resolve_options(opts)
+
+    var xn = x0
+    var iters uint32
+
+    for iters = 0; iters < opts.MaxIterations; iters += 1 {
+        if !opts.Accelerate {
+            var xnext = g(xn)
+
+            if math.Abs(xnext-xn) < opts.Tolerance {
+                return iters + 1
+            }
+
+            xn = xnext
+            continue
+        }
+
+        var x1 = g(xn)
+        var x2 = g(x1)
+        var accelerated = aitken_delta_squared(xn, x1, x2)
+
+        if math.Abs(accelerated-xn) < opts.Tolerance {
+            return iters + 1
+        }
+
+        xn = accelerated
+    }
+
+    return iters
+}
+/*  End of fixed_point_iteration_count.                                       */
@@ -34,47 +34,85 @@ import (
 /*  Type for a function of the form f: R -> R.                                */
 type realfunc func(x float64) float64
 
-/*  Computes the root of a function using Steffensen's method.                */
+/*  Computes the root of a function using Steffensen's method. This is a      *
+ *  thin wrapper around fixed_point: taking g(x) = x + f(x) turns a root of   *
+ *  f into a fixed point of g, and enabling acceleration applies the Aitken   *
+ *  Delta-squared transform this method is built on, in fixed_point.go.       */
 func steffensens_method(f realfunc, x float64) float64 {
+    var g = func(xi float64) float64 {
+        return xi + f(xi)
+    }
+
+    return fixed_point(g, x, options{Accelerate: true})
+}
+/*  End of steffensens_method.                                                */
+
+/*  One row of diagnostic output from steffensens_method_with_trace,          *
+ *  recording everything needed to empirically check the order of            *
+ *  convergence.                                                              */
+type trace_record struct {
+    Iteration    int
+    X            float64
+    FX           float64
+    BracketWidth float64
+    ErrorRatio   float64
+}
 
-    /*  Steffensen's method is iterative and converges very quickly.          *
-     *  Because of this we may exit the function after a few iterations.      */
-    const maximum_number_of_iterations uint32 = 16
+/*  Same algorithm as steffensens_method, but records a trace_record for      *
+ *  every accelerated step instead of only returning the final estimate.     *
+ *  Drives fixed_point/aitken_delta_squared exactly the way steffensens_      *
+ *  method does, so a future fix to the Aitken denominator guard in          *
+ *  fixed_point.go applies here too instead of silently drifting out of      *
+ *  sync. Steffensen's method has quadratic convergence, order p = 2, same   *
+ *  as Newton's method and Heron's method.                                   */
+func steffensens_method_with_trace(f realfunc, x float64) []trace_record {
 
-    /*  The maximum allowed error. This is 4x double precision epsilon.       */
-    const epsilon float64 = 8.881784197001252e-16
+    const p float64 = 2.0
+
+    var opts = fixed_point_resolve_options(options{Accelerate: true})
+    var g = func(xi float64) float64 {
+        return xi + f(xi)
+    }
 
-    /*  Variable for keeping track of how many iterations we have performed.  */
     var iters uint32
+    var xn = x
+    var trace []trace_record
+
+    /*  Previous two iterates, needed to compute ErrorRatio.                  */
+    var x_prev, x_prev_prev float64
+    var have_prev, have_prev_prev bool
 
-    /*  The method starts at the provided guess point and updates iteratively.*/
-    var xn float64 = x
+    for iters = 0; iters < opts.MaxIterations; iters += 1 {
+        var f_xn = f(xn)
+        var error_ratio = math.NaN()
 
-    /*  Iteratively apply Steffensen's method to find the root.               */
-    for iters = 0; iters < maximum_number_of_iterations; iters += 1 {
+        if have_prev && have_prev_prev {
+            error_ratio = math.Abs(xn-x_prev) / math.Pow(math.Abs(x_prev-x_prev_prev), p)
+        }
 
-        /*  Steffensen's method needs the evaluations f(x) and f(x + f(x)),   *
-         *  in particular the denominator is f(x + f(x)) / f(x) - 1. Compute. */
-        var f_xn float64 = f(xn)
-        var g_xn float64 = f(xn + f_xn) / f_xn - 1.0
+        trace = append(trace, trace_record{
+            Iteration:    int(iters),
+            X:            xn,
+            FX:           f_xn,
+            BracketWidth: 0.0,
+            ErrorRatio:   error_ratio,
+        })
 
-        /*  Like Newton's method, the new point is obtained by subtracting    *
-         *  the ratio. g(x) = f(x + f(x)) / f(x) - 1 acts as the derivative   *
-         *  of f, but we do not explicitly need to calculate f'(x).           */
-        xn = xn - f_xn / g_xn
+        x_prev_prev, have_prev_prev = x_prev, have_prev
+        x_prev, have_prev = xn, true
 
-        /*  If f(x) is very small, we are close to a root and can break out   *
-         *  of this for loop. Check for this.                                 */
-        if math.Abs(f_xn) < epsilon {
+        if math.Abs(f_xn) < opts.Tolerance {
             break
         }
+
+        var x1 = g(xn)
+        var x2 = g(x1)
+        xn = aitken_delta_squared(xn, x1, x2)
     }
 
-    /*  Like Newton's method, and like Heron's method, the convergence is     *
-     *  quadratic. After a few iterations we will be very to close a root.    */
-    return xn
+    return trace
 }
-/*  End of steffensens_method.                                                */
+/*  End of steffensens_method_with_trace.                                     */
 
 /*  sqrt(2) is a root to the function f(x) = 2 - x^2. Provide this.           */
 func f(x float64) float64 {
@@ -0,0 +1,214 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of mitx_mathematics_programming_examples.               *
+ *                                                                            *
+ *  mitx_mathematics_programming_examples is free software: you can           *
+ *  redistribute it and/or modify it under the terms of the GNU General       *
+ *  Public License as published by the Free Software Foundation, either       *
+ *  version 3 of the License, or (at your option) any later version.         *
+ *                                                                            *
+ *  mitx_mathematics_programming_examples is distributed in the hope that     *
+ *  it will be useful but WITHOUT ANY WARRANTY; without even the implied      *
+ *  warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.          *
+ *  See the GNU General Public License for more details.                      *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with mitx_mathematics_programming_examples. If not, see             *
+ *  <https://www.gnu.org/licenses/>.                                          *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Calculates the root of a function using trisection, and its           *
+ *      generalization, n-section.                                           *
+ ******************************************************************************
+ *  Author: Ryan Maguire                                                      *
+ *  Date:   2026/07/26                                                       *
+ ******************************************************************************/
+package main
+
+/*  Only standard library imports are needed.                                 */
+import (
+    "fmt"   /*  Printf provided here, used for printing text to the screen.   */
+    "math"  /*  Abs and NaN, found here.                                      */
+)
+
+/*  Function pointer notation is a little confusing. Create a typedef for it  *
+ *  so we do not need to explicitly use it later.                             */
+type realfunc func(x float64) float64
+
+/*  Computes the root of a function using the trisection method. Like         *
+ *  bisection, but the bracket is split into thirds each step instead of      *
+ *  halves. The bracket shrinks to 2/3 of its previous width per iteration,   *
+ *  slower than bisection's 1/2, but useful when the extra midpoint           *
+ *  evaluation is cheap, or simply for pedagogy.                              */
+func trisection_method(f realfunc, a float64, b float64) float64 {
+
+    /*  Same iteration cap reasoning as bisection_method. Since trisection    *
+     *  only shrinks the bracket by a factor of 2/3 per step instead of 1/2,  *
+     *  give it more room to work with.                                       */
+    const maximum_number_of_iterations uint32 = 128
+
+    /*  Getting exact roots is hard using floating-point numbers. Allow a     *
+     *  tolerance in our computation. This value is double precision epsilon. */
+    const epsilon float64 = 2.220446049250313E-16
+
+    /*  Variable for keeping track of how many iterations we have performed.  */
+    var iters uint32
+
+    /*  The current bracket, updated as we iterate.                          */
+    var left, right float64
+
+    /*  Evaluate f at the two endpoints to determine which is positive and    *
+     *  which is negative. We transform [a, b] to [left, right] by doing this.*/
+    var a_eval = f(a)
+    var b_eval = f(b)
+
+    /*  Rare case, f(a) = 0. Return a, no trisection needed.                  */
+    if a_eval == 0.0 {
+        return a
+    }
+
+    /*  Similarly, if f(b) = 0, then we have already found the root. Return b.*/
+    if b_eval == 0.0 {
+        return b
+    }
+
+    /*  We need one evaluation to be negative and one to be positive.         *
+     *  Abort if both have the same sign.                                     */
+    if a_eval*b_eval > 0.0 {
+        return (a - a) / (a - a)
+    }
+
+    /*  Orient the bracket so that f(left) < 0 < f(right).                    */
+    if a_eval < 0.0 {
+        left, right = a, b
+    } else {
+        left, right = b, a
+    }
+
+    /*  Iteratively divide the bracket into thirds to find the root.          */
+    for iters = 0; iters < maximum_number_of_iterations; iters += 1 {
+
+        /*  Evaluate f at the two points that split [left, right] into thirds.*/
+        var third = (right - left) / 3.0
+        var m1 = left + third
+        var m2 = left + 2.0*third
+        var m1_eval = f(m1)
+
+        /*  If f(x) is very small, we are close to a root and can break out   *
+         *  of this for loop. Check for this.                                 */
+        if math.Abs(m1_eval) <= epsilon {
+            return m1
+        }
+
+        /*  Sign change is in the first third. Shrink the bracket and loop.   */
+        if f(left)*m1_eval < 0.0 {
+            right = m1
+            continue
+        }
+
+        var m2_eval = f(m2)
+
+        if math.Abs(m2_eval) <= epsilon {
+            return m2
+        }
+
+        /*  Sign change is in the middle third.                               */
+        if m1_eval*m2_eval < 0.0 {
+            left = m1
+            right = m2
+            continue
+        }
+
+        /*  Otherwise the sign change must be in the final third.             */
+        left = m2
+    }
+
+    /*  Ran out of iterations. Return the midpoint of whatever bracket we     *
+     *  ended up with.                                                        */
+    return 0.5 * (left + right)
+}
+/*  End of trisection_method.                                                 */
+
+/*  Generalizes bisection and trisection to n equal subintervals per step.    *
+ *  Samples f at the n-1 interior points of [a, b], finds the first adjacent  *
+ *  pair with opposing signs, and recurses on that sub-bracket. If no sign    *
+ *  change is found among the samples, returns NaN; the caller should pick a  *
+ *  larger n or a narrower starting bracket.                                  */
+func nsection_method(f realfunc, a float64, b float64, n int) float64 {
+
+    /*  Same reasoning as bisection_method and trisection_method. The larger  *
+     *  n is, the more the bracket shrinks per step, so fewer steps needed.   */
+    const maximum_number_of_iterations uint32 = 128
+
+    /*  Getting exact roots is hard using floating-point numbers. Allow a     *
+     *  tolerance in our computation. This value is double precision epsilon. */
+    const epsilon float64 = 2.220446049250313E-16
+
+    /*  Need at least two subintervals, otherwise there is nothing to do.     */
+    if n < 2 {
+        return math.NaN()
+    }
+
+    var iters uint32
+    var left, right = a, b
+
+    for iters = 0; iters < maximum_number_of_iterations; iters += 1 {
+        var width = (right - left) / float64(n)
+        var x = left
+        var x_eval = f(left)
+        var found = false
+
+        /*  Sample the n-1 interior points, looking for the first adjacent    *
+         *  pair with a sign change.                                          */
+        var index int
+        for index = 1; index < n; index += 1 {
+            var next = left + float64(index)*width
+            var next_eval = f(next)
+
+            if math.Abs(next_eval) <= epsilon {
+                return next
+            }
+
+            if x_eval*next_eval < 0.0 {
+                left, right = x, next
+                found = true
+                break
+            }
+
+            x, x_eval = next, next_eval
+        }
+
+        /*  Check the final subinterval, [left + (n-1)*width, right].         */
+        if !found {
+            var right_eval = f(right)
+
+            if x_eval*right_eval < 0.0 {
+                left = x
+                found = true
+            }
+        }
+
+        /*  None of the n samples bracketed a sign change. Give up.           */
+        if !found {
+            return math.NaN()
+        }
+    }
+
+    return 0.5 * (left + right)
+}
+/*  End of nsection_method.                                                   */
+
+/*  Main routine used for testing our implementations.                        */
+func main() {
+
+    /*  pi is somewhere between 3 and 4, and it is a root to sine.            */
+    const a float64 = 3.0
+    const b float64 = 4.0
+
+    var pi_trisection = trisection_method(math.Sin, a, b)
+    var pi_nsection = nsection_method(math.Sin, a, b, 5)
+
+    fmt.Printf("pi (trisection) = %.16f\n", pi_trisection)
+    fmt.Printf("pi (5-section)  = %.16f\n", pi_nsection)
+}
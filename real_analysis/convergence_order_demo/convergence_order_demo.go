@@ -0,0 +1,178 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of mitx_mathematics_programming_examples.               *
+ *                                                                            *
+ *  mitx_mathematics_programming_examples is free software: you can           *
+ *  redistribute it and/or modify it under the terms of the GNU General       *
+ *  Public License as published by the Free Software Foundation, either       *
+ *  version 3 of the License, or (at your option) any later version.         *
+ *                                                                            *
+ *  mitx_mathematics_programming_examples is distributed in the hope that     *
+ *  it will be useful but WITHOUT ANY WARRANTY; without even the implied      *
+ *  warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.          *
+ *  See the GNU General Public License for more details.                      *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with mitx_mathematics_programming_examples. If not, see             *
+ *  <https://www.gnu.org/licenses/>.                                          *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Prints a side-by-side table of the per-iteration error ratios for     *
+ *      bisection and Heron's method on the same problem, sqrt(2), making     *
+ *      the difference between linear and quadratic convergence visible.     *
+ *      Each routine here is a standalone copy of the *_with_trace variant    *
+ *      living alongside its method, the same way realfunc is redefined in   *
+ *      every file in this project rather than imported from a shared place. *
+ ******************************************************************************
+ *  Author: Ryan Maguire                                                      *
+ *  Date:   2026/07/26                                                       *
+ ******************************************************************************/
+package main
+
+/*  Only standard library imports are needed.                                 */
+import (
+    "fmt"   /*  Printf provided here, used for printing text to the screen.   */
+    "math"  /*  Abs, Pow, and NaN, found here.                                */
+)
+
+/*  Function pointer notation is a little confusing. Create a typedef for it  *
+ *  so we do not need to explicitly use it later.                             */
+type realfunc func(x float64) float64
+
+/*  One row of diagnostic output, recording everything needed to empirically  *
+ *  check the order of convergence.                                          */
+type trace_record struct {
+    Iteration  int
+    X          float64
+    FX         float64
+    ErrorRatio float64
+}
+
+/*  Copy of bisection_method_with_trace, used here to find sqrt(2) as the     *
+ *  root of f(x) = 2 - x^2 on [1, 2]. Bisection has linear convergence,       *
+ *  order p = 1.                                                              */
+func bisection_with_trace(f realfunc, a float64, b float64) []trace_record {
+
+    const maximum_number_of_iterations uint32 = 64
+    const epsilon float64 = 2.220446049250313E-16
+    const p float64 = 1.0
+
+    var iters uint32
+    var trace []trace_record
+    var x_prev, x_prev_prev float64
+    var have_prev, have_prev_prev bool
+
+    /*  Evaluate f at the two endpoints to determine which is positive and    *
+     *  which is negative, same sanity check and orientation as              *
+     *  bisection_method_with_trace: we need left/right such that            *
+     *  f(left) < 0 < f(right) before the update step below is valid.        */
+    var a_eval = f(a)
+    var b_eval = f(b)
+    var left, right float64
+
+    if a_eval < b_eval {
+        if b_eval < 0.0 || a_eval > 0.0 {
+            return nil
+        }
+
+        left, right = a, b
+    } else {
+        if a_eval < 0.0 || b_eval > 0.0 {
+            return nil
+        }
+
+        left, right = b, a
+    }
+
+    var midpoint = 0.5 * (a + b)
+
+    for iters = 0; iters < maximum_number_of_iterations; iters += 1 {
+        var eval = f(midpoint)
+        var error_ratio = math.NaN()
+
+        if have_prev && have_prev_prev {
+            error_ratio = math.Abs(midpoint-x_prev) / math.Pow(math.Abs(x_prev-x_prev_prev), p)
+        }
+
+        trace = append(trace, trace_record{Iteration: int(iters), X: midpoint, FX: eval, ErrorRatio: error_ratio})
+
+        x_prev_prev, have_prev_prev = x_prev, have_prev
+        x_prev, have_prev = midpoint, true
+
+        if math.Abs(eval) <= epsilon {
+            break
+        }
+
+        if eval < 0.0 {
+            left = midpoint
+            midpoint = 0.5 * (midpoint + right)
+        } else {
+            right = midpoint
+            midpoint = 0.5 * (left + midpoint)
+        }
+    }
+
+    return trace
+}
+/*  End of bisection_with_trace.                                              */
+
+/*  Copy of herons_method_with_trace, used here to find sqrt(x). Heron's      *
+ *  method has quadratic convergence, order p = 2.                           */
+func heron_with_trace(x float64) []trace_record {
+
+    const maximum_number_of_iterations uint32 = 16
+    const epsilon float64 = 8.881784197001252E-16
+    const p float64 = 2.0
+
+    var approximate_root = x
+    var trace []trace_record
+    var x_prev, x_prev_prev float64
+    var have_prev, have_prev_prev bool
+    var iters uint32
+
+    for iters = 0; iters < maximum_number_of_iterations; iters += 1 {
+        var error = (x - approximate_root*approximate_root) / x
+        var error_ratio = math.NaN()
+
+        if have_prev && have_prev_prev {
+            error_ratio = math.Abs(approximate_root-x_prev) / math.Pow(math.Abs(x_prev-x_prev_prev), p)
+        }
+
+        trace = append(trace, trace_record{Iteration: int(iters), X: approximate_root, FX: error, ErrorRatio: error_ratio})
+
+        x_prev_prev, have_prev_prev = x_prev, have_prev
+        x_prev, have_prev = approximate_root, true
+
+        if math.Abs(error) <= epsilon {
+            break
+        }
+
+        approximate_root = 0.5 * (approximate_root + x/approximate_root)
+    }
+
+    return trace
+}
+/*  End of heron_with_trace.                                                  */
+
+/*  Prints one trace as a table of iteration / estimate / error ratio.        */
+func print_trace(name string, trace []trace_record) {
+    fmt.Printf("%s:\n", name)
+
+    for _, record := range trace {
+        fmt.Printf("  iter %2d:  x = %.16f  error ratio = %.6f\n", record.Iteration, record.X, record.ErrorRatio)
+    }
+}
+/*  End of print_trace.                                                       */
+
+/*  Main routine comparing the convergence rate of bisection and Heron's      *
+ *  method on sqrt(2). Heron's error ratio should settle down to a roughly    *
+ *  constant value (quadratic convergence), while bisection's error ratio     *
+ *  settles near 0.5 (linear convergence, the bracket halves every step).     */
+func main() {
+    var bisection_trace = bisection_with_trace(func(x float64) float64 { return 2.0 - x*x }, 1.0, 2.0)
+    var heron_trace = heron_with_trace(2.0)
+
+    print_trace("bisection (linear, p = 1)", bisection_trace)
+    print_trace("heron (quadratic, p = 2)", heron_trace)
+}
@@ -73,6 +73,64 @@ func herons_method(x float64) float64 {
 }
 /*  End of herons_method.                                                     */
 
+/*  One row of diagnostic output from herons_method_with_trace, recording      *
+ *  everything needed to empirically check the order of convergence.          */
+type trace_record struct {
+    Iteration    int
+    X            float64
+    FX           float64
+    BracketWidth float64
+    ErrorRatio   float64
+}
+
+/*  Same algorithm as herons_method, but records a trace_record for every     *
+ *  iteration instead of only returning the final approximation. Heron's      *
+ *  method has quadratic convergence, order p = 2, so once the iterates are   *
+ *  close to the root, ErrorRatio here should settle near 0.5 / sqrt(x).      */
+func herons_method_with_trace(x float64) []trace_record {
+
+    const maximum_number_of_iterations uint32 = 16
+    const epsilon float64 = 8.881784197001252E-16
+    const p float64 = 2.0
+
+    var iters uint32
+    var approximate_root = x
+    var trace []trace_record
+
+    /*  Previous two iterates, needed to compute ErrorRatio.                  */
+    var x_prev, x_prev_prev float64
+    var have_prev, have_prev_prev bool
+
+    for iters = 0; iters < maximum_number_of_iterations; iters += 1 {
+        var error = (x - approximate_root*approximate_root) / x
+        var error_ratio = math.NaN()
+
+        if have_prev && have_prev_prev {
+            error_ratio = math.Abs(approximate_root-x_prev) / math.Pow(math.Abs(x_prev-x_prev_prev), p)
+        }
+
+        trace = append(trace, trace_record{
+            Iteration:    int(iters),
+            X:            approximate_root,
+            FX:           error,
+            BracketWidth: 0.0,
+            ErrorRatio:   error_ratio,
+        })
+
+        x_prev_prev, have_prev_prev = x_prev, have_prev
+        x_prev, have_prev = approximate_root, true
+
+        if math.Abs(error) <= epsilon {
+            break
+        }
+
+        approximate_root = 0.5 * (approximate_root + x/approximate_root)
+    }
+
+    return trace
+}
+/*  End of herons_method_with_trace.                                          */
+
 /*  Main routine used for testing our implementation of Heron's method.       */
 func main() {
 
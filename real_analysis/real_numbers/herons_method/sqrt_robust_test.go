@@ -0,0 +1,87 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of mitx_mathematics_programming_examples.               *
+ *                                                                            *
+ *  mitx_mathematics_programming_examples is free software: you can           *
+ *  redistribute it and/or modify it under the terms of the GNU General       *
+ *  Public License as published by the Free Software Foundation, either       *
+ *  version 3 of the License, or (at your option) any later version.         *
+ *                                                                            *
+ *  mitx_mathematics_programming_examples is distributed in the hope that     *
+ *  it will be useful but WITHOUT ANY WARRANTY; without even the implied      *
+ *  warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.          *
+ *  See the GNU General Public License for more details.                      *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with mitx_mathematics_programming_examples. If not, see             *
+ *  <https://www.gnu.org/licenses/>.                                          *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Table-driven test for sqrt_robust's special-case handling, in the     *
+ *      style of the all_test.go special-value tables in Go's math package.   *
+ ******************************************************************************
+ *  Author: Ryan Maguire                                                      *
+ *  Date:   2026/07/26                                                       *
+ ******************************************************************************/
+package main
+
+import (
+    "math"
+    "testing"
+)
+
+/*  Special inputs where the expected output is checked by predicate rather   *
+ *  than by value (NaN, signed zero, and infinity all need their own check).  */
+func TestSqrtRobustSpecialValues(t *testing.T) {
+    var negative_zero = math.Copysign(0.0, -1.0)
+
+    var cases = []struct {
+        name  string
+        input float64
+        check func(float64) bool
+    }{
+        {"NaN", math.NaN(), math.IsNaN},
+        {"-1", -1.0, math.IsNaN},
+        {"-Inf", math.Inf(-1), math.IsNaN},
+        {"+0", 0.0, func(got float64) bool { return got == 0.0 && math.Signbit(got) == false }},
+        {"-0", negative_zero, func(got float64) bool { return got == 0.0 && math.Signbit(got) == true }},
+        {"+Inf", math.Inf(1), func(got float64) bool { return math.IsInf(got, 1) }},
+    }
+
+    for _, test := range cases {
+        var got = sqrt_robust(test.input)
+
+        if !test.check(got) {
+            t.Errorf("sqrt_robust(%v) = %v, failed special-case check %q", test.input, got, test.name)
+        }
+    }
+}
+
+/*  Ordinary inputs, including subnormals and magnitudes far from 1, checked   *
+ *  against math.Sqrt within a small relative tolerance.                      */
+func TestSqrtRobustMagnitudes(t *testing.T) {
+    const relative_tolerance = 1.0e-14
+
+    var inputs = []float64{
+        1.0,
+        2.0,
+        4.0,
+        0.25,
+        1.0e-300,
+        1.0e300,
+        5.0e-324, /*  Smallest positive subnormal double.                    */
+        math.SmallestNonzeroFloat64,
+        math.MaxFloat64,
+    }
+
+    for _, x := range inputs {
+        var got = sqrt_robust(x)
+        var want = math.Sqrt(x)
+        var relative_error = math.Abs(got-want) / want
+
+        if relative_error > relative_tolerance {
+            t.Errorf("sqrt_robust(%v) = %v, want %v (relative error %v)", x, got, want, relative_error)
+        }
+    }
+}
@@ -0,0 +1,89 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of mitx_mathematics_programming_examples.               *
+ *                                                                            *
+ *  mitx_mathematics_programming_examples is free software: you can           *
+ *  redistribute it and/or modify it under the terms of the GNU General       *
+ *  Public License as published by the Free Software Foundation, either       *
+ *  version 3 of the License, or (at your option) any later version.         *
+ *                                                                            *
+ *  mitx_mathematics_programming_examples is distributed in the hope that     *
+ *  it will be useful but WITHOUT ANY WARRANTY; without even the implied      *
+ *  warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.          *
+ *  See the GNU General Public License for more details.                      *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with mitx_mathematics_programming_examples. If not, see             *
+ *  <https://www.gnu.org/licenses/>.                                          *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Bisection as a Solver, the bracketing workhorse the rest of the       *
+ *      package falls back on when a fancier method is not applicable.        *
+ ******************************************************************************
+ *  Author: Ryan Maguire                                                      *
+ *  Date:   2026/07/26                                                       *
+ ******************************************************************************/
+package roots
+
+import "math"
+
+/*  BisectionSolver implements Solver using the bisection method.             */
+type BisectionSolver struct{}
+
+/*  Solve repeatedly halves opts.Bracket, keeping the half with a sign        *
+ *  change, until f is small or the iteration budget runs out.                */
+func (BisectionSolver) Solve(f realfunc, opts Options) Result {
+
+    if opts.Bracket == nil {
+        return Result{Status: NoBracket}
+    }
+
+    var tol = tolerance(opts)
+    var max_iters = maxIterations(opts)
+
+    var a = opts.Bracket.Left
+    var b = opts.Bracket.Right
+    var a_eval = f(a)
+    var b_eval = f(b)
+
+    if a_eval == 0.0 {
+        return Result{Root: a, Status: Converged}
+    }
+
+    if b_eval == 0.0 {
+        return Result{Root: b, Status: Converged}
+    }
+
+    if a_eval*b_eval > 0.0 {
+        return Result{Status: NoBracket}
+    }
+
+    /*  Orient left/right so that f(left) < 0 < f(right).                     */
+    var left, right = a, b
+
+    if a_eval > 0.0 {
+        left, right = b, a
+    }
+
+    var midpoint = 0.5 * (left + right)
+    var iters uint32
+
+    for iters = 0; iters < max_iters; iters += 1 {
+        var eval = f(midpoint)
+
+        if math.Abs(eval) <= tol {
+            return Result{Root: midpoint, Iterations: iters, Residual: eval, Status: Converged}
+        }
+
+        if eval < 0.0 {
+            left = midpoint
+            midpoint = 0.5 * (midpoint + right)
+        } else {
+            right = midpoint
+            midpoint = 0.5 * (left + midpoint)
+        }
+    }
+
+    return Result{Root: midpoint, Iterations: iters, Residual: f(midpoint), Status: MaxIterations}
+}
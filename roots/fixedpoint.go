@@ -0,0 +1,59 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of mitx_mathematics_programming_examples.               *
+ *                                                                            *
+ *  mitx_mathematics_programming_examples is free software: you can           *
+ *  redistribute it and/or modify it under the terms of the GNU General       *
+ *  Public License as published by the Free Software Foundation, either       *
+ *  version 3 of the License, or (at your option) any later version.         *
+ *                                                                            *
+ *  mitx_mathematics_programming_examples is distributed in the hope that     *
+ *  it will be useful but WITHOUT ANY WARRANTY; without even the implied      *
+ *  warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.          *
+ *  See the GNU General Public License for more details.                      *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with mitx_mathematics_programming_examples. If not, see             *
+ *  <https://www.gnu.org/licenses/>.                                          *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Fixed-point iteration as a Solver, finding a fixed point of G rather   *
+ *      than a root of f directly (take G(x) = x + f(x) to recover a root     *
+ *      finder for f).                                                        *
+ ******************************************************************************
+ *  Author: Ryan Maguire                                                      *
+ *  Date:   2026/07/26                                                       *
+ ******************************************************************************/
+package roots
+
+import "math"
+
+/*  FixedPointSolver implements Solver by iterating x_{n+1} = G(x_n). The     *
+ *  function passed to Solve is only used to report the Residual of the       *
+ *  Result; G drives the iteration.                                          */
+type FixedPointSolver struct {
+    G realfunc
+}
+
+/*  Solve iterates G from opts.InitialGuess until successive iterates are     *
+ *  within tolerance of one another or the iteration budget runs out.         */
+func (solver FixedPointSolver) Solve(f realfunc, opts Options) Result {
+
+    var tol = tolerance(opts)
+    var max_iters = maxIterations(opts)
+    var xn = opts.InitialGuess
+    var iters uint32
+
+    for iters = 0; iters < max_iters; iters += 1 {
+        var xnext = solver.G(xn)
+
+        if math.Abs(xnext-xn) <= tol {
+            return Result{Root: xnext, Iterations: iters, Residual: f(xnext), Status: Converged}
+        }
+
+        xn = xnext
+    }
+
+    return Result{Root: xn, Iterations: iters, Residual: f(xn), Status: MaxIterations}
+}
@@ -0,0 +1,61 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of mitx_mathematics_programming_examples.               *
+ *                                                                            *
+ *  mitx_mathematics_programming_examples is free software: you can           *
+ *  redistribute it and/or modify it under the terms of the GNU General       *
+ *  Public License as published by the Free Software Foundation, either       *
+ *  version 3 of the License, or (at your option) any later version.         *
+ *                                                                            *
+ *  mitx_mathematics_programming_examples is distributed in the hope that     *
+ *  it will be useful but WITHOUT ANY WARRANTY; without even the implied      *
+ *  warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.          *
+ *  See the GNU General Public License for more details.                      *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with mitx_mathematics_programming_examples. If not, see             *
+ *  <https://www.gnu.org/licenses/>.                                          *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Newton's method as a Solver.                                          *
+ ******************************************************************************
+ *  Author: Ryan Maguire                                                      *
+ *  Date:   2026/07/26                                                       *
+ ******************************************************************************/
+package roots
+
+import "math"
+
+/*  NewtonSolver implements Solver using Newton's method. FPrime must be the  *
+ *  derivative of the function passed to Solve.                               */
+type NewtonSolver struct {
+    FPrime realfunc
+}
+
+/*  Solve iterates x_{n+1} = x_n - f(x_n) / f'(x_n) from opts.InitialGuess.   */
+func (solver NewtonSolver) Solve(f realfunc, opts Options) Result {
+
+    var tol = tolerance(opts)
+    var max_iters = maxIterations(opts)
+    var xn = opts.InitialGuess
+    var iters uint32
+
+    for iters = 0; iters < max_iters; iters += 1 {
+        var f_xn = f(xn)
+
+        if math.Abs(f_xn) <= tol {
+            return Result{Root: xn, Iterations: iters, Residual: f_xn, Status: Converged}
+        }
+
+        var fprime_xn = solver.FPrime(xn)
+
+        if fprime_xn == 0.0 {
+            return Result{Root: xn, Iterations: iters, Residual: f_xn, Status: Diverged}
+        }
+
+        xn = xn - f_xn/fprime_xn
+    }
+
+    return Result{Root: xn, Iterations: iters, Residual: f(xn), Status: MaxIterations}
+}
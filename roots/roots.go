@@ -0,0 +1,169 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of mitx_mathematics_programming_examples.               *
+ *                                                                            *
+ *  mitx_mathematics_programming_examples is free software: you can           *
+ *  redistribute it and/or modify it under the terms of the GNU General       *
+ *  Public License as published by the Free Software Foundation, either       *
+ *  version 3 of the License, or (at your option) any later version.         *
+ *                                                                            *
+ *  mitx_mathematics_programming_examples is distributed in the hope that     *
+ *  it will be useful but WITHOUT ANY WARRANTY; without even the implied      *
+ *  warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.          *
+ *  See the GNU General Public License for more details.                      *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with mitx_mathematics_programming_examples. If not, see             *
+ *  <https://www.gnu.org/licenses/>.                                          *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Provides a common interface for the various root-finding methods      *
+ *      scattered across real_analysis (bisection, Steffensen, Heron) so      *
+ *      that they may be used interchangeably. Mirrors the surface of the     *
+ *      numerical-analysis Haskell module (bisect, trisect, has_root,         *
+ *      fixed_point, newtons_method, secant_method).                          *
+ ******************************************************************************
+ *  Author: Ryan Maguire                                                      *
+ *  Date:   2026/07/26                                                       *
+ ******************************************************************************/
+package roots
+
+/*  Type for a function of the form f: R -> R, shared by every solver.        */
+type realfunc func(x float64) float64
+
+/*  Default tolerance, 4x double precision epsilon. Matches the value used    *
+ *  throughout the individual root-finders in real_analysis.                  */
+const defaultTolerance float64 = 8.881784197001252E-16
+
+/*  Default iteration cap. Generous enough for bisection on a bracket of      *
+ *  width ~1, stingy enough to avoid spinning forever on a bad input.         */
+const defaultMaxIterations uint32 = 64
+
+/*  Status indicates why a solver stopped iterating.                          */
+type Status int
+
+const (
+
+    /*  The solver found a root to within the requested tolerance.            */
+    Converged Status = iota
+
+    /*  The solver exhausted its iteration budget before converging.          */
+    MaxIterations
+
+    /*  A bracketing solver was given an interval with no sign change.        */
+    NoBracket
+
+    /*  The iterates grew without bound instead of approaching a root.        */
+    Diverged
+)
+
+/*  String gives a human-readable name for a Status, used when printing.      */
+func (status Status) String() string {
+    switch status {
+    case Converged:
+        return "Converged"
+    case MaxIterations:
+        return "MaxIterations"
+    case NoBracket:
+        return "NoBracket"
+    case Diverged:
+        return "Diverged"
+    default:
+        return "Unknown"
+    }
+}
+
+/*  Bracket is an interval [Left, Right] known (or hoped) to contain a root.   */
+type Bracket struct {
+    Left, Right float64
+}
+
+/*  Options configures a solver. Not every field is used by every method:     *
+ *  bracketing solvers (Bisection, Trisection, Secant) read Bracket, point-    *
+ *  based solvers (Newton, Steffensen, FixedPoint) read InitialGuess.         */
+type Options struct {
+
+    /*  How close |f(x)| (or |x_{n+1} - x_n}|) must be to zero to declare      *
+     *  convergence. Zero means "use the package default".                    */
+    Tolerance float64
+
+    /*  Maximum number of iterations to perform. Zero means "use the          *
+     *  package default".                                                     */
+    MaxIterations uint32
+
+    /*  Starting point for point-based solvers.                               */
+    InitialGuess float64
+
+    /*  Starting interval for bracketing solvers. Nil means "no bracket".     */
+    Bracket *Bracket
+}
+
+/*  Result is returned by every solver, recording not just the root but how    *
+ *  the search went.                                                          */
+type Result struct {
+    Root       float64
+    Iterations uint32
+    Residual   float64
+    Status     Status
+}
+
+/*  Solver is implemented by every root-finding method in this package.       */
+type Solver interface {
+    Solve(f realfunc, opts Options) Result
+}
+
+/*  tolerance extracts the tolerance from opts, falling back to the package    *
+ *  default when the caller left it as the zero value.                        */
+func tolerance(opts Options) float64 {
+    if opts.Tolerance > 0.0 {
+        return opts.Tolerance
+    }
+
+    return defaultTolerance
+}
+
+/*  maxIterations extracts the iteration cap from opts, falling back to the   *
+ *  package default when the caller left it as the zero value.                */
+func maxIterations(opts Options) uint32 {
+    if opts.MaxIterations > 0 {
+        return opts.MaxIterations
+    }
+
+    return defaultMaxIterations
+}
+
+/*  HasRoot checks for a sign change of f across [a, b]. With no sampling      *
+ *  density given it only checks the two endpoints, the same check every      *
+ *  bracketing solver in this package performs before it starts iterating.    *
+ *  Passing a positive delta samples f every delta units across [a, b] and    *
+ *  looks for an adjacent pair with opposite signs, mirroring the optional    *
+ *  "Maybe delta" sampling density of the Haskell has_root function.          */
+func HasRoot(f realfunc, a float64, b float64, delta ...float64) bool {
+    if len(delta) == 0 || delta[0] <= 0.0 {
+        return f(a)*f(b) < 0.0
+    }
+
+    var step = delta[0]
+    var left = a
+    var left_eval = f(a)
+
+    for left < b {
+        var right = left + step
+
+        if right > b {
+            right = b
+        }
+
+        var right_eval = f(right)
+
+        if left_eval*right_eval < 0.0 {
+            return true
+        }
+
+        left = right
+        left_eval = right_eval
+    }
+
+    return false
+}
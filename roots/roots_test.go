@@ -0,0 +1,116 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of mitx_mathematics_programming_examples.               *
+ *                                                                            *
+ *  mitx_mathematics_programming_examples is free software: you can           *
+ *  redistribute it and/or modify it under the terms of the GNU General       *
+ *  Public License as published by the Free Software Foundation, either       *
+ *  version 3 of the License, or (at your option) any later version.         *
+ *                                                                            *
+ *  mitx_mathematics_programming_examples is distributed in the hope that     *
+ *  it will be useful but WITHOUT ANY WARRANTY; without even the implied      *
+ *  warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.          *
+ *  See the GNU General Public License for more details.                      *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with mitx_mathematics_programming_examples. If not, see             *
+ *  <https://www.gnu.org/licenses/>.                                          *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Exercises every Solver in this package, and HasRoot, against the      *
+ *      shared test problem f(x) = 2 - x^2, whose positive root is sqrt(2).   *
+ ******************************************************************************
+ *  Author: Ryan Maguire                                                      *
+ *  Date:   2026/07/26                                                       *
+ ******************************************************************************/
+package roots
+
+import (
+    "math"
+    "testing"
+)
+
+/*  The shared test problem: f(x) = 2 - x^2, whose positive root is sqrt(2).  */
+func quadratic(x float64) float64 {
+    return 2.0 - x*x
+}
+
+const sqrt2 = 1.4142135623730951
+const testTolerance = 1.0e-9
+
+/*  checkRoot fails the test unless result converged to sqrt(2).              */
+func checkRoot(t *testing.T, name string, result Result) {
+    t.Helper()
+
+    if result.Status != Converged {
+        t.Fatalf("%s: Status = %v, want Converged (Result = %+v)", name, result.Status, result)
+    }
+
+    if math.Abs(result.Root-sqrt2) > testTolerance {
+        t.Fatalf("%s: Root = %.16f, want %.16f", name, result.Root, sqrt2)
+    }
+}
+
+func TestBisectionSolver(t *testing.T) {
+    var result = BisectionSolver{}.Solve(quadratic, Options{Bracket: &Bracket{Left: 1.0, Right: 2.0}})
+    checkRoot(t, "BisectionSolver", result)
+}
+
+func TestBisectionSolverNoBracket(t *testing.T) {
+    var result = BisectionSolver{}.Solve(quadratic, Options{Bracket: &Bracket{Left: 3.0, Right: 4.0}})
+
+    if result.Status != NoBracket {
+        t.Fatalf("Status = %v, want NoBracket", result.Status)
+    }
+}
+
+func TestTrisectionSolver(t *testing.T) {
+    var result = TrisectionSolver{}.Solve(quadratic, Options{Bracket: &Bracket{Left: 1.0, Right: 2.0}})
+    checkRoot(t, "TrisectionSolver", result)
+}
+
+func TestSecantSolver(t *testing.T) {
+    var result = SecantSolver{}.Solve(quadratic, Options{Bracket: &Bracket{Left: 1.0, Right: 2.0}})
+    checkRoot(t, "SecantSolver", result)
+}
+
+func TestNewtonSolver(t *testing.T) {
+    var solver = NewtonSolver{FPrime: func(x float64) float64 { return -2.0 * x }}
+    var result = solver.Solve(quadratic, Options{InitialGuess: 1.5})
+    checkRoot(t, "NewtonSolver", result)
+}
+
+func TestSteffensenSolver(t *testing.T) {
+    var result = SteffensenSolver{}.Solve(quadratic, Options{InitialGuess: 1.5})
+    checkRoot(t, "SteffensenSolver", result)
+}
+
+func TestFixedPointSolver(t *testing.T) {
+    /*  Heron's iteration, x_{n+1} = (x_n + 2/x_n) / 2, is a contraction near  *
+     *  sqrt(2); g(x) = x + f(x) is not, so the latter would not converge      *
+     *  under plain (unaccelerated) fixed-point iteration.                    */
+    var solver = FixedPointSolver{G: func(x float64) float64 { return 0.5 * (x + 2.0/x) }}
+    var result = solver.Solve(quadratic, Options{InitialGuess: 1.5})
+    checkRoot(t, "FixedPointSolver", result)
+}
+
+func TestHasRoot(t *testing.T) {
+    if !HasRoot(quadratic, 1.0, 2.0) {
+        t.Error("HasRoot(quadratic, 1, 2) = false, want true")
+    }
+
+    if HasRoot(quadratic, 3.0, 4.0) {
+        t.Error("HasRoot(quadratic, 3, 4) = true, want false")
+    }
+
+    /*  Neither endpoint alone brackets the root, but sampling with a small   *
+     *  enough delta should find the sign change between samples.            */
+    if !HasRoot(quadratic, -3.0, 3.0, 0.5) {
+        t.Error("HasRoot(quadratic, -3, 3, 0.5) = false, want true")
+    }
+
+    if HasRoot(quadratic, 3.0, 4.0, 0.1) {
+        t.Error("HasRoot(quadratic, 3, 4, 0.1) = true, want false")
+    }
+}
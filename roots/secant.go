@@ -0,0 +1,71 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of mitx_mathematics_programming_examples.               *
+ *                                                                            *
+ *  mitx_mathematics_programming_examples is free software: you can           *
+ *  redistribute it and/or modify it under the terms of the GNU General       *
+ *  Public License as published by the Free Software Foundation, either       *
+ *  version 3 of the License, or (at your option) any later version.         *
+ *                                                                            *
+ *  mitx_mathematics_programming_examples is distributed in the hope that     *
+ *  it will be useful but WITHOUT ANY WARRANTY; without even the implied      *
+ *  warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.          *
+ *  See the GNU General Public License for more details.                      *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with mitx_mathematics_programming_examples. If not, see             *
+ *  <https://www.gnu.org/licenses/>.                                          *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      The secant method as a Solver, approximating Newton's method by       *
+ *      replacing the derivative with a finite difference of the last two     *
+ *      iterates.                                                             *
+ ******************************************************************************
+ *  Author: Ryan Maguire                                                      *
+ *  Date:   2026/07/26                                                       *
+ ******************************************************************************/
+package roots
+
+import "math"
+
+/*  SecantSolver implements Solver using the secant method. opts.Bracket      *
+ *  supplies the two starting points x0 = Left, x1 = Right; unlike the        *
+ *  bracketing solvers these need not straddle a root.                        */
+type SecantSolver struct{}
+
+/*  Solve draws a line through the last two iterates and uses its root as     *
+ *  the next iterate.                                                         */
+func (SecantSolver) Solve(f realfunc, opts Options) Result {
+
+    if opts.Bracket == nil {
+        return Result{Status: NoBracket}
+    }
+
+    var tol = tolerance(opts)
+    var max_iters = maxIterations(opts)
+
+    var x0 = opts.Bracket.Left
+    var x1 = opts.Bracket.Right
+    var f0 = f(x0)
+    var f1 = f(x1)
+    var iters uint32
+
+    for iters = 0; iters < max_iters; iters += 1 {
+        if math.Abs(f1) <= tol {
+            return Result{Root: x1, Iterations: iters, Residual: f1, Status: Converged}
+        }
+
+        if f1 == f0 {
+            return Result{Root: x1, Iterations: iters, Residual: f1, Status: Diverged}
+        }
+
+        var x2 = x1 - f1*(x1-x0)/(f1-f0)
+        var f2 = f(x2)
+
+        x0, f0 = x1, f1
+        x1, f1 = x2, f2
+    }
+
+    return Result{Root: x1, Iterations: iters, Residual: f1, Status: MaxIterations}
+}
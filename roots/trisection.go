@@ -0,0 +1,101 @@
+/******************************************************************************
+ *                                  LICENSE                                   *
+ ******************************************************************************
+ *  This file is part of mitx_mathematics_programming_examples.               *
+ *                                                                            *
+ *  mitx_mathematics_programming_examples is free software: you can           *
+ *  redistribute it and/or modify it under the terms of the GNU General       *
+ *  Public License as published by the Free Software Foundation, either       *
+ *  version 3 of the License, or (at your option) any later version.         *
+ *                                                                            *
+ *  mitx_mathematics_programming_examples is distributed in the hope that     *
+ *  it will be useful but WITHOUT ANY WARRANTY; without even the implied      *
+ *  warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.          *
+ *  See the GNU General Public License for more details.                      *
+ *                                                                            *
+ *  You should have received a copy of the GNU General Public License         *
+ *  along with mitx_mathematics_programming_examples. If not, see             *
+ *  <https://www.gnu.org/licenses/>.                                          *
+ ******************************************************************************
+ *  Purpose:                                                                  *
+ *      Trisection as a Solver, the three-way analogue of bisection, in the   *
+ *      spirit of the trisect function in the referenced Haskell module.      *
+ ******************************************************************************
+ *  Author: Ryan Maguire                                                      *
+ *  Date:   2026/07/26                                                       *
+ ******************************************************************************/
+package roots
+
+import "math"
+
+/*  TrisectionSolver implements Solver using the trisection method.           */
+type TrisectionSolver struct{}
+
+/*  Solve splits opts.Bracket into thirds each step, keeping whichever third   *
+ *  contains a sign change. Shrinks the bracket to 2/3 of its width per        *
+ *  iteration rather than bisection's 1/2, so it converges more slowly, but    *
+ *  it is handy pedagogically and when the midpoint evaluations are cheap.     */
+func (TrisectionSolver) Solve(f realfunc, opts Options) Result {
+
+    if opts.Bracket == nil {
+        return Result{Status: NoBracket}
+    }
+
+    var tol = tolerance(opts)
+    var max_iters = maxIterations(opts)
+
+    var a = opts.Bracket.Left
+    var b = opts.Bracket.Right
+    var a_eval = f(a)
+    var b_eval = f(b)
+
+    if a_eval == 0.0 {
+        return Result{Root: a, Status: Converged}
+    }
+
+    if b_eval == 0.0 {
+        return Result{Root: b, Status: Converged}
+    }
+
+    if a_eval*b_eval > 0.0 {
+        return Result{Status: NoBracket}
+    }
+
+    var left, right = a, b
+    var left_eval = a_eval
+    var iters uint32
+
+    for iters = 0; iters < max_iters; iters += 1 {
+        var third = (right - left) / 3.0
+        var m1 = left + third
+        var m2 = left + 2.0*third
+        var m1_eval = f(m1)
+
+        if math.Abs(m1_eval) <= tol {
+            return Result{Root: m1, Iterations: iters, Residual: m1_eval, Status: Converged}
+        }
+
+        if left_eval*m1_eval < 0.0 {
+            right = m1
+            continue
+        }
+
+        var m2_eval = f(m2)
+
+        if math.Abs(m2_eval) <= tol {
+            return Result{Root: m2, Iterations: iters, Residual: m2_eval, Status: Converged}
+        }
+
+        if m1_eval*m2_eval < 0.0 {
+            left, left_eval = m1, m1_eval
+            right = m2
+            continue
+        }
+
+        /*  Sign change must be in the final third.                           */
+        left, left_eval = m2, m2_eval
+    }
+
+    var midpoint = 0.5 * (left + right)
+    return Result{Root: midpoint, Iterations: iters, Residual: f(midpoint), Status: MaxIterations}
+}